@@ -2,6 +2,7 @@ package wirego
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -9,9 +10,13 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/fullstorydev/grpchan/inprocgrpc"
+	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/soheilhy/cmux"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
@@ -19,17 +24,25 @@ import (
 
 // App represents the main application structure
 type App struct {
-	options     *Options
-	grpcServer  *grpc.Server
-	httpServer  *http.Server
-	healthCheck *health.Server
-	mux         *runtime.ServeMux
-	httpMux     *http.ServeMux
+	options       *Options
+	grpcServer    *grpc.Server
+	httpServer    *http.Server
+	healthCheck   *health.Server
+	mux           *runtime.ServeMux
+	httpMux       *http.ServeMux
+	listener      net.Listener // shared listener used when running in single-port mode
+	inprocChannel *inprocgrpc.Channel
+	tlsManager    *tlsManager   // non-nil when WithTLS is configured, for cert reload
+	binaryLogger  *binaryLogger // non-nil when WithBinaryLog is configured, for drain shutdown
+	serviceInfo   *ServiceInfo
+	healthCancel  context.CancelFunc // stops background health checker goroutines, set in Run
 }
 
-// GRPCProvider is an interface for any service that can register with gRPC
+// GRPCProvider is an interface for any service that can register with gRPC. It accepts a
+// grpc.ServiceRegistrar rather than a concrete *grpc.Server so the same service can also be
+// registered on the in-process channel used by RegisterHTTPWithClient.
 type GRPCProvider interface {
-	RegisterGRPC(*grpc.Server)
+	RegisterGRPC(grpc.ServiceRegistrar)
 }
 
 // HTTPProvider is an interface for services that can register HTTP handlers
@@ -37,6 +50,14 @@ type HTTPProvider interface {
 	RegisterHTTP(context.Context, *runtime.ServeMux) error
 }
 
+// HTTPClientProvider is an interface for services that register HTTP handlers backed by a
+// gRPC client connection instead of redialing the gRPC server over the network. When App
+// drives this path it passes an in-process channel, so gateway handlers invoke the
+// registered gRPC service directly in-memory.
+type HTTPClientProvider interface {
+	RegisterHTTPWithClient(ctx context.Context, mux *runtime.ServeMux, conn grpc.ClientConnInterface) error
+}
+
 // Service is a unified interface for services that can register with both gRPC and HTTP
 type Service interface {
 	GRPCProvider
@@ -59,6 +80,45 @@ func NewApp(ctx context.Context, opts ...Option) (*App, error) {
 		return nil, fmt.Errorf("gRPC port must be specified and be greater than 0")
 	}
 
+	if options.singlePort > 0 && options.httpPort > 0 {
+		return nil, fmt.Errorf("WithSinglePort cannot be combined with WithHTTPPort")
+	}
+
+	if options.singlePort > 0 && (options.tlsConfig != nil || options.tlsCertFile != "") {
+		return nil, fmt.Errorf("WithSinglePort cannot be combined with WithTLS or WithTLSConfig: " +
+			"single-port serving multiplexes on the plaintext byte stream, which a TLS handshake never matches")
+	}
+
+	// Populate X-Forwarded-For from the PROXY-protocol-resolved remote address before any
+	// other HTTP middleware or handler runs, so they see the true client through the
+	// conventional header.
+	if options.proxyProtocol {
+		options.httpMiddleware = append([]func(http.Handler) http.Handler{ForwardedForMiddleware()}, options.httpMiddleware...)
+	}
+
+	// Wire binary request/response logging into the interceptor and middleware chains. All
+	// three share one binaryLogger (and its single drain goroutine) since they all write to
+	// the same configured sink, which is not guaranteed to be safe for concurrent callers.
+	// The logger is stashed on App so Shutdown can drain and stop it.
+	var bl *binaryLogger
+	if options.binaryLog != nil {
+		bl = newBinaryLogger(*options.binaryLog, options.logger)
+		options.unaryInterceptors = append(options.unaryInterceptors, binaryLoggingUnaryInterceptor(bl))
+		options.streamInterceptors = append(options.streamInterceptors, binaryLoggingStreamInterceptor(bl))
+		options.httpMiddleware = append(options.httpMiddleware, binaryLoggingMiddleware(bl))
+	}
+
+	// Wire request validation into the interceptor chains
+	if options.protoValidator != nil {
+		options.unaryInterceptors = append(options.unaryInterceptors, ValidationUnaryInterceptor(options.protoValidator))
+		options.streamInterceptors = append(options.streamInterceptors, ValidationStreamInterceptor(options.protoValidator))
+	}
+
+	tlsConfig, tlsMgr, err := buildTLSConfig(options)
+	if err != nil {
+		return nil, err
+	}
+
 	var httpServer *http.Server
 	var httpMux *http.ServeMux
 	var gwMux *runtime.ServeMux
@@ -66,10 +126,14 @@ func NewApp(ctx context.Context, opts ...Option) (*App, error) {
 	healthCheck := health.NewServer()
 
 	// Create gRPC server with interceptors
-	grpcServer := grpc.NewServer(
+	grpcServerOpts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(options.unaryInterceptors...),
 		grpc.ChainStreamInterceptor(options.streamInterceptors...),
-	)
+	}
+	if tlsConfig != nil {
+		grpcServerOpts = append(grpcServerOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	grpcServer := grpc.NewServer(grpcServerOpts...)
 	// Register health check service
 	healthpb.RegisterHealthServer(grpcServer, healthCheck)
 
@@ -78,8 +142,8 @@ func NewApp(ctx context.Context, opts ...Option) (*App, error) {
 		reflection.Register(grpcServer)
 	}
 
-	// Create HTTP server for gRPC-Gateway if port is specified
-	if options.httpPort > 0 {
+	// Create HTTP server for gRPC-Gateway if a port is specified, either standalone or shared (single-port mode)
+	if options.httpPort > 0 || options.singlePort > 0 {
 		// Create HTTP mux for gRPC-Gateway
 		gwMux = runtime.NewServeMux(options.muxOptions...)
 
@@ -92,23 +156,51 @@ func NewApp(ctx context.Context, opts ...Option) (*App, error) {
 		// Handle gRPC-Gateway requests
 		httpMux.Handle("/", gwMux)
 
-		// Create HTTP server with configured mux
+		// Create HTTP server with configured mux.
+		// In single-port mode the server is served on a cmux sub-listener instead of Addr.
 		httpServer = &http.Server{
-			Addr:    fmt.Sprintf(":%d", options.httpPort),
-			Handler: options.wrapHTTPHandler(httpMux),
+			Addr:      fmt.Sprintf(":%d", options.httpPort),
+			Handler:   options.wrapHTTPHandler(httpMux),
+			TLSConfig: tlsConfig,
 		}
 	}
 
+	if tlsMgr != nil {
+		go tlsMgr.watch(options.tlsWatchInterval)
+	}
+
 	return &App{
-		options:     options,
-		grpcServer:  grpcServer,
-		httpServer:  httpServer,
-		healthCheck: healthCheck,
-		mux:         gwMux,
-		httpMux:     httpMux,
+		options:       options,
+		grpcServer:    grpcServer,
+		httpServer:    httpServer,
+		healthCheck:   healthCheck,
+		mux:           gwMux,
+		httpMux:       httpMux,
+		inprocChannel: newInprocChannel(options),
+		tlsManager:    tlsMgr,
+		binaryLogger:  bl,
+		serviceInfo:   buildServiceInfo(options, uuid.NewString()),
 	}, nil
 }
 
+// listenHTTP binds the HTTP listener synchronously, so Run calls the registry only once the
+// port can accept connections
+func (a *App) listenHTTP() (net.Listener, error) {
+	lis, err := net.Listen("tcp", a.httpServer.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on HTTP port: %w", err)
+	}
+	return wrapProxyProtocol(lis, a.options.proxyProtocol), nil
+}
+
+// serveHTTP serves a.httpServer on lis, using TLS if WithTLS/WithTLSConfig configured it
+func (a *App) serveHTTP(lis net.Listener) error {
+	if a.httpServer.TLSConfig != nil {
+		return a.httpServer.ServeTLS(lis, "", "")
+	}
+	return a.httpServer.Serve(lis)
+}
+
 // Run starts the application servers and blocks until shutdown
 func (a *App) Run(ctx context.Context, service GRPCProvider) error {
 	// Set health check to serving
@@ -117,8 +209,19 @@ func (a *App) Run(ctx context.Context, service GRPCProvider) error {
 	// Create error group for concurrent server management
 	g, ctx := errgroup.WithContext(ctx)
 
-	// Start gRPC server
-	a.startGRPCServer(g, service)
+	// Register the service with the gRPC server and, so RegisterHTTPWithClient can dispatch
+	// calls in-process, with the in-process channel
+	service.RegisterGRPC(a.grpcServer)
+	service.RegisterGRPC(a.inprocChannel)
+
+	// Start background probing for any registered per-service health checks. These use their
+	// own cancelable context rather than the errgroup's: a clean Shutdown stops the servers
+	// without any of them returning an error, so the errgroup context is never canceled, and
+	// checkers tied to it would spin forever and keep g.Wait() from returning.
+	healthCtx, healthCancel := context.WithCancel(ctx)
+	a.healthCancel = healthCancel
+	defer healthCancel()
+	a.startHealthCheckers(healthCtx, g)
 
 	// Register readiness checks if available
 	if a.httpMux != nil && a.httpServer != nil {
@@ -128,43 +231,92 @@ func (a *App) Run(ctx context.Context, service GRPCProvider) error {
 		}
 	}
 
-	// Start HTTP server if initialized
-	if a.httpServer != nil && a.mux != nil {
-		// Check if service implements HTTPProvider
-		if httpProvider, ok := service.(HTTPProvider); ok {
-			if err := a.startHTTPServer(ctx, g, httpProvider); err != nil {
+	if a.options.singlePort > 0 {
+		// Serve gRPC and the HTTP gateway multiplexed on a single shared listener. This binds
+		// its listener synchronously before returning.
+		if err := a.startSinglePortServer(ctx, g, service); err != nil {
+			return err
+		}
+	} else {
+		// Bind the gRPC (and, if configured, HTTP) listeners synchronously so the registry
+		// below advertises an instance that can already accept connections, rather than
+		// racing the listen calls against Register.
+		grpcLis, err := a.listenGRPC()
+		if err != nil {
+			return err
+		}
+		a.startGRPCServer(g, grpcLis)
+
+		// Start HTTP server if initialized
+		if a.httpServer != nil && a.mux != nil {
+			httpLis, err := a.listenHTTP()
+			if err != nil {
 				return err
 			}
-		} else {
-			// Start HTTP server without registering HTTP handlers
-			g.Go(func() error {
-				a.options.logger.Info("starting HTTP server", "port", a.options.httpPort)
-				if err := a.httpServer.ListenAndServe(); err != http.ErrServerClosed {
-					return fmt.Errorf("HTTP server error: %w", err)
+
+			if a.hasHTTPHandlers(service) {
+				if err := a.startHTTPServer(ctx, g, service, httpLis); err != nil {
+					return err
 				}
-				return nil
-			})
+			} else {
+				// Start HTTP server without registering HTTP handlers
+				g.Go(func() error {
+					a.options.logger.Info("starting HTTP server", "port", a.options.httpPort)
+					if err := a.serveHTTP(httpLis); err != http.ErrServerClosed {
+						return fmt.Errorf("HTTP server error: %w", err)
+					}
+					return nil
+				})
+			}
 		}
 	}
 
+	// Advertise this instance to the configured Registry now that listeners are up
+	if err := a.options.registry.Register(ctx, a.serviceInfo); err != nil {
+		a.options.logger.Error("failed to register with service registry", "error", err)
+	}
+
 	// Handle graceful shutdown
 	a.handleGracefulShutdown(ctx, g)
 
 	return g.Wait()
 }
 
-// startGRPCServer initializes and starts the gRPC server
-func (a *App) startGRPCServer(g *errgroup.Group, service GRPCProvider) {
-	// Register service with gRPC server
-	service.RegisterGRPC(a.grpcServer)
+// hasHTTPHandlers reports whether the service registers HTTP handlers via either HTTPProvider
+// or HTTPClientProvider
+func (a *App) hasHTTPHandlers(service GRPCProvider) bool {
+	if _, ok := service.(HTTPClientProvider); ok {
+		return true
+	}
+	_, ok := service.(HTTPProvider)
+	return ok
+}
 
-	// Start gRPC server
-	g.Go(func() error {
-		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", a.options.grpcPort))
-		if err != nil {
-			return fmt.Errorf("failed to listen on gRPC port: %w", err)
-		}
+// registerHTTPHandlers registers the service's HTTP handlers, preferring HTTPClientProvider
+// (wired to the in-process gRPC channel) over HTTPProvider
+func (a *App) registerHTTPHandlers(ctx context.Context, service GRPCProvider) error {
+	if clientProvider, ok := service.(HTTPClientProvider); ok {
+		return clientProvider.RegisterHTTPWithClient(ctx, a.mux, a.inprocChannel)
+	}
+	if httpProvider, ok := service.(HTTPProvider); ok {
+		return httpProvider.RegisterHTTP(ctx, a.mux)
+	}
+	return nil
+}
+
+// listenGRPC binds the gRPC listener synchronously, so Run calls the registry only once the
+// port can accept connections
+func (a *App) listenGRPC() (net.Listener, error) {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", a.options.grpcPort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on gRPC port: %w", err)
+	}
+	return wrapProxyProtocol(lis, a.options.proxyProtocol), nil
+}
 
+// startGRPCServer serves the gRPC server on lis; the service must already be registered
+func (a *App) startGRPCServer(g *errgroup.Group, lis net.Listener) {
+	g.Go(func() error {
 		a.options.logger.Info("starting gRPC server", "port", a.options.grpcPort)
 		if err := a.grpcServer.Serve(lis); err != nil {
 			return fmt.Errorf("gRPC server error: %w", err)
@@ -173,17 +325,17 @@ func (a *App) startGRPCServer(g *errgroup.Group, service GRPCProvider) {
 	})
 }
 
-// startHTTPServer initializes and starts the HTTP server
-func (a *App) startHTTPServer(ctx context.Context, g *errgroup.Group, provider HTTPProvider) error {
+// startHTTPServer registers HTTP handlers and serves the HTTP server on lis
+func (a *App) startHTTPServer(ctx context.Context, g *errgroup.Group, service GRPCProvider, lis net.Listener) error {
 	// Register HTTP handlers
-	if err := provider.RegisterHTTP(ctx, a.mux); err != nil {
+	if err := a.registerHTTPHandlers(ctx, service); err != nil {
 		return fmt.Errorf("failed to register HTTP handlers: %w", err)
 	}
 
 	// Start HTTP server
 	g.Go(func() error {
 		a.options.logger.Info("starting HTTP server", "port", a.options.httpPort)
-		if err := a.httpServer.ListenAndServe(); err != http.ErrServerClosed {
+		if err := a.serveHTTP(lis); err != http.ErrServerClosed {
 			return fmt.Errorf("HTTP server error: %w", err)
 		}
 		return nil
@@ -192,6 +344,57 @@ func (a *App) startHTTPServer(ctx context.Context, g *errgroup.Group, provider H
 	return nil
 }
 
+// startSinglePortServer initializes and starts gRPC and the HTTP gateway on a single
+// shared listener, multiplexing connections by content type via cmux. The service must
+// already be registered with the gRPC server and in-process channel.
+func (a *App) startSinglePortServer(ctx context.Context, g *errgroup.Group, service GRPCProvider) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", a.options.singlePort))
+	if err != nil {
+		return fmt.Errorf("failed to listen on single port: %w", err)
+	}
+	lis = wrapProxyProtocol(lis, a.options.proxyProtocol)
+	a.listener = lis
+
+	// Register HTTP handlers before serving
+	if a.httpServer != nil && a.mux != nil && a.hasHTTPHandlers(service) {
+		if err := a.registerHTTPHandlers(ctx, service); err != nil {
+			return fmt.Errorf("failed to register HTTP handlers: %w", err)
+		}
+	}
+
+	// Split the shared listener into gRPC and HTTP/1.1 sub-listeners
+	m := cmux.New(lis)
+	grpcLis := m.MatchWithWriters(cmux.HTTP2MatchHeaderFieldSendSettings("content-type", "application/grpc"))
+	httpLis := m.Match(cmux.HTTP1Fast())
+
+	g.Go(func() error {
+		a.options.logger.Info("starting gRPC server", "port", a.options.singlePort)
+		if err := a.grpcServer.Serve(grpcLis); err != nil && !errors.Is(err, cmux.ErrListenerClosed) {
+			return fmt.Errorf("gRPC server error: %w", err)
+		}
+		return nil
+	})
+
+	if a.httpServer != nil {
+		g.Go(func() error {
+			a.options.logger.Info("starting HTTP server", "port", a.options.singlePort)
+			if err := a.httpServer.Serve(httpLis); err != nil && !errors.Is(err, http.ErrServerClosed) && !errors.Is(err, cmux.ErrListenerClosed) {
+				return fmt.Errorf("HTTP server error: %w", err)
+			}
+			return nil
+		})
+	}
+
+	g.Go(func() error {
+		if err := m.Serve(); err != nil && !errors.Is(err, cmux.ErrListenerClosed) {
+			return fmt.Errorf("cmux serve error: %w", err)
+		}
+		return nil
+	})
+
+	return nil
+}
+
 // handleGracefulShutdown manages graceful shutdown on signals or context done
 func (a *App) handleGracefulShutdown(ctx context.Context, g *errgroup.Group) {
 	g.Go(func() error {
@@ -216,10 +419,20 @@ func (a *App) Shutdown() {
 	// Set health check to not serving
 	a.healthCheck.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
 
+	// Stop the background health checker goroutines started in Run, if any
+	if a.healthCancel != nil {
+		a.healthCancel()
+	}
+
 	// Create a timeout context for shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), a.options.shutdownTimeout)
 	defer cancel()
 
+	// Deregister from the service registry before the servers stop accepting connections
+	if err := a.options.registry.Deregister(ctx, a.serviceInfo); err != nil {
+		a.options.logger.Error("failed to deregister from service registry", "error", err)
+	}
+
 	// Shutdown HTTP server
 	if a.httpServer != nil {
 		if err := a.httpServer.Shutdown(ctx); err != nil {
@@ -244,4 +457,22 @@ func (a *App) Shutdown() {
 			a.options.logger.Info("gRPC server stopped gracefully")
 		}
 	}
+
+	// Close the shared listener once both servers have drained (single-port mode)
+	if a.listener != nil {
+		if err := a.listener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			a.options.logger.Error("error closing single port listener", "error", err)
+		}
+	}
+
+	// Stop the TLS certificate watcher, if any
+	if a.tlsManager != nil {
+		a.tlsManager.stop()
+	}
+
+	// Stop the binary logger's drain goroutine, if any, now that both servers (its only
+	// producers) have stopped
+	if a.binaryLogger != nil {
+		a.binaryLogger.close()
+	}
 }
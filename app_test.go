@@ -0,0 +1,70 @@
+package wirego
+
+import (
+	"context"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// freePort asks the OS for an unused TCP port, for tests that need to bind a concrete port
+// number rather than ":0".
+func freePort(t *testing.T) int {
+	t.Helper()
+	lis, err := net.Listen("tcp", ":0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	defer lis.Close()
+	return lis.Addr().(*net.TCPAddr).Port
+}
+
+type noopHealthChecker struct{}
+
+func (noopHealthChecker) Name() string                { return "noop" }
+func (noopHealthChecker) Check(context.Context) error { return nil }
+
+// TestRun_ShutdownStopsHealthCheckers guards against a regression where health checker
+// goroutines were tied to the errgroup's own context, which a clean Shutdown never canceled,
+// leaving Run blocked forever whenever WithHealthChecks was configured.
+func TestRun_ShutdownStopsHealthCheckers(t *testing.T) {
+	app, err := NewApp(context.Background(),
+		WithGRPCPort(freePort(t)),
+		WithHealthChecks(noopHealthChecker{}),
+		WithHealthCheckInterval(10*time.Millisecond),
+		WithShutdownTimeout(time.Second),
+	)
+	if err != nil {
+		t.Fatalf("NewApp() error = %v", err)
+	}
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(context.Background(), fakeService{})
+	}()
+
+	// Give Run a moment to start serving, then send SIGTERM so handleGracefulShutdown takes
+	// the same path a real deployment's shutdown signal would: the signal handler calls
+	// Shutdown() directly, with Run's own context never canceled.
+	time.Sleep(50 * time.Millisecond)
+	if err := syscall.Kill(os.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatalf("failed to send SIGTERM: %v", err)
+	}
+
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("Run() did not return after Shutdown(); health checker goroutines likely leaked")
+	}
+}
+
+type fakeService struct{}
+
+func (fakeService) RegisterGRPC(grpc.ServiceRegistrar) {}
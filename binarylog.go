@@ -0,0 +1,425 @@
+package wirego
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	binlogpb "google.golang.org/grpc/binarylog/grpc_binarylog_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BinaryLogSink receives serialized GrpcLogEntry records. A binaryLogger only ever calls
+// Write from its single dedicated goroutine, so implementations need not be concurrency-safe.
+type BinaryLogSink interface {
+	Write(entry *binlogpb.GrpcLogEntry) error
+}
+
+// writerSink is a BinaryLogSink that writes length-prefixed protobuf records to an io.Writer,
+// the same framing used by grpc-go's binarylog, so entries can be replayed with existing tooling.
+type writerSink struct {
+	w io.Writer
+}
+
+// NewWriterSink creates a BinaryLogSink that writes length-prefixed GrpcLogEntry records to w
+func NewWriterSink(w io.Writer) BinaryLogSink {
+	return &writerSink{w: w}
+}
+
+func (s *writerSink) Write(entry *binlogpb.GrpcLogEntry) error {
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	var lenBuf [4]byte
+	binary.BigEndian.PutUint32(lenBuf[:], uint32(len(data)))
+
+	if _, err := s.w.Write(lenBuf[:]); err != nil {
+		return err
+	}
+	_, err = s.w.Write(data)
+	return err
+}
+
+// NewFileSink opens (creating if needed) the file at path and returns a BinaryLogSink that
+// appends length-prefixed GrpcLogEntry records to it. The caller owns the returned file and
+// is responsible for closing it on shutdown.
+func NewFileSink(path string) (BinaryLogSink, *os.File, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewWriterSink(f), f, nil
+}
+
+// BinaryLogRule allows or denies binary logging for a service/method pair. An empty Service
+// or Method matches any value. Rules are evaluated in order; the first match wins.
+type BinaryLogRule struct {
+	Service string
+	Method  string
+	Allow   bool
+}
+
+func (r BinaryLogRule) matches(service, method string) bool {
+	if r.Service != "" && r.Service != service {
+		return false
+	}
+	if r.Method != "" && r.Method != method {
+		return false
+	}
+	return true
+}
+
+// BinaryLogConfig configures binary request/response logging for both gRPC and HTTP traffic
+type BinaryLogConfig struct {
+	// Sink receives serialized log entries. Required.
+	Sink BinaryLogSink
+	// Rules filter which service/method calls are logged, evaluated in order; the first
+	// matching rule wins. A call that matches no rule is logged.
+	Rules []BinaryLogRule
+	// MaxPayloadBytes truncates logged message payloads beyond this size. Zero means unlimited.
+	MaxPayloadBytes int
+	// RedactHeaders lists metadata/header keys (case-insensitive) whose values are replaced
+	// with a redacted marker before serialization.
+	RedactHeaders []string
+	// QueueSize bounds how many entries are buffered for the async sink goroutine. Once full,
+	// further entries are dropped and counted so the RPC path never blocks on sink I/O.
+	// Defaults to 1024 when zero.
+	QueueSize int
+}
+
+func (c BinaryLogConfig) allowed(service, method string) bool {
+	for _, rule := range c.Rules {
+		if rule.matches(service, method) {
+			return rule.Allow
+		}
+	}
+	return true
+}
+
+func (c BinaryLogConfig) isRedacted(key string) bool {
+	for _, redacted := range c.RedactHeaders {
+		if strings.EqualFold(redacted, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact replaces the values of any key in RedactHeaders with a fixed marker
+func (c BinaryLogConfig) redact(md metadata.MD) *binlogpb.Metadata {
+	entries := make([]*binlogpb.MetadataEntry, 0, len(md))
+	for key, values := range md {
+		for _, value := range values {
+			if c.isRedacted(key) {
+				value = "[REDACTED]"
+			}
+			entries = append(entries, &binlogpb.MetadataEntry{Key: key, Value: []byte(value)})
+		}
+	}
+	return &binlogpb.Metadata{Entry: entries}
+}
+
+// truncate applies MaxPayloadBytes to data, reporting whether it truncated the payload
+func (c BinaryLogConfig) truncate(data []byte) ([]byte, bool) {
+	if c.MaxPayloadBytes <= 0 || len(data) <= c.MaxPayloadBytes {
+		return data, false
+	}
+	return data[:c.MaxPayloadBytes], true
+}
+
+// binaryLogger builds GrpcLogEntry records and hands them to the configured sink through a
+// bounded, asynchronous queue so a slow or blocked sink never adds latency to the RPC path.
+type binaryLogger struct {
+	cfg     BinaryLogConfig
+	queue   chan *binlogpb.GrpcLogEntry
+	dropped atomic.Uint64
+	nextID  atomic.Uint64
+	logger  *slog.Logger
+	doneCh  chan struct{}
+}
+
+func newBinaryLogger(cfg BinaryLogConfig, logger *slog.Logger) *binaryLogger {
+	size := cfg.QueueSize
+	if size <= 0 {
+		size = 1024
+	}
+
+	bl := &binaryLogger{
+		cfg:    cfg,
+		queue:  make(chan *binlogpb.GrpcLogEntry, size),
+		logger: logger,
+		doneCh: make(chan struct{}),
+	}
+	go bl.drain()
+	return bl
+}
+
+// drain writes queued entries to the sink until the queue is closed
+func (bl *binaryLogger) drain() {
+	defer close(bl.doneCh)
+	for entry := range bl.queue {
+		if err := bl.cfg.Sink.Write(entry); err != nil {
+			bl.logger.Error("binary log sink write failed", "error", err)
+		}
+	}
+}
+
+// close closes the queue and waits for the drain goroutine to flush it and exit. An enqueue
+// after close panics, since it sends on the closed queue.
+func (bl *binaryLogger) close() {
+	close(bl.queue)
+	<-bl.doneCh
+}
+
+// enqueue submits an entry for async writing, dropping (and counting) it if the queue is full
+func (bl *binaryLogger) enqueue(entry *binlogpb.GrpcLogEntry) {
+	select {
+	case bl.queue <- entry:
+	default:
+		dropped := bl.dropped.Add(1)
+		bl.logger.Warn("binary log queue full, dropping entry", "dropped_total", dropped)
+	}
+}
+
+func (bl *binaryLogger) newCallID() uint64 {
+	return bl.nextID.Add(1)
+}
+
+// headerEntry builds a ClientHeader event carrying the method name and redacted metadata
+func (bl *binaryLogger) headerEntry(callID, seq uint64, method string, md metadata.MD) *binlogpb.GrpcLogEntry {
+	return &binlogpb.GrpcLogEntry{
+		Timestamp:            timestamppb.Now(),
+		CallId:               callID,
+		SequenceIdWithinCall: seq,
+		Type:                 binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HEADER,
+		Logger:               binlogpb.GrpcLogEntry_LOGGER_SERVER,
+		Payload: &binlogpb.GrpcLogEntry_ClientHeader{
+			ClientHeader: &binlogpb.ClientHeader{
+				Metadata:   bl.cfg.redact(md),
+				MethodName: method,
+			},
+		},
+	}
+}
+
+// rawMessageEntry builds a Message event from an already-encoded payload
+func (bl *binaryLogger) rawMessageEntry(callID, seq uint64, eventType binlogpb.GrpcLogEntry_EventType, data []byte) *binlogpb.GrpcLogEntry {
+	data, truncated := bl.cfg.truncate(data)
+
+	return &binlogpb.GrpcLogEntry{
+		Timestamp:            timestamppb.Now(),
+		CallId:               callID,
+		SequenceIdWithinCall: seq,
+		Type:                 eventType,
+		Logger:               binlogpb.GrpcLogEntry_LOGGER_SERVER,
+		PayloadTruncated:     truncated,
+		Payload: &binlogpb.GrpcLogEntry_Message{
+			Message: &binlogpb.Message{Length: uint32(len(data)), Data: data},
+		},
+	}
+}
+
+// messageEntry builds a Message event for a proto message, marshaling it first
+func (bl *binaryLogger) messageEntry(callID, seq uint64, eventType binlogpb.GrpcLogEntry_EventType, msg proto.Message) *binlogpb.GrpcLogEntry {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		bl.logger.Error("binary log marshal failed", "error", err)
+		data = nil
+	}
+	return bl.rawMessageEntry(callID, seq, eventType, data)
+}
+
+// trailerEntry builds a Trailer event recording the final RPC status
+func (bl *binaryLogger) trailerEntry(callID, seq uint64, err error) *binlogpb.GrpcLogEntry {
+	st, _ := status.FromError(err)
+
+	return &binlogpb.GrpcLogEntry{
+		Timestamp:            timestamppb.Now(),
+		CallId:               callID,
+		SequenceIdWithinCall: seq,
+		Type:                 binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_TRAILER,
+		Logger:               binlogpb.GrpcLogEntry_LOGGER_SERVER,
+		Payload: &binlogpb.GrpcLogEntry_Trailer{
+			Trailer: &binlogpb.Trailer{
+				StatusCode:    uint32(st.Code()),
+				StatusMessage: st.Message(),
+			},
+		},
+	}
+}
+
+// callSeq generates sequence numbers scoped to a single logged call
+type callSeq struct {
+	n atomic.Uint64
+}
+
+func (s *callSeq) next() uint64 {
+	return s.n.Add(1)
+}
+
+// splitMethod splits a gRPC FullMethod ("/service/method") into its service and method parts
+func splitMethod(fullMethod string) (service, method string) {
+	trimmed := strings.TrimPrefix(fullMethod, "/")
+	parts := strings.SplitN(trimmed, "/", 2)
+	if len(parts) != 2 {
+		return trimmed, ""
+	}
+	return parts[0], parts[1]
+}
+
+// BinaryLoggingUnaryInterceptor creates a gRPC unary interceptor that asynchronously records
+// the full request/response payload and metadata for each call to cfg.Sink, filtered by
+// cfg.Rules. It never blocks the RPC path: entries are written on a dedicated goroutine.
+func BinaryLoggingUnaryInterceptor(cfg BinaryLogConfig, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return binaryLoggingUnaryInterceptor(newBinaryLogger(cfg, logger))
+}
+
+// binaryLoggingUnaryInterceptor builds the interceptor against an already-constructed
+// binaryLogger, so callers that wire several interceptors/middleware against the same sink
+// (see NewApp) can share one logger and its single drain goroutine instead of racing
+// independent writers against cfg.Sink.
+func binaryLoggingUnaryInterceptor(bl *binaryLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, method := splitMethod(info.FullMethod)
+		if !bl.cfg.allowed(service, method) {
+			return handler(ctx, req)
+		}
+
+		callID := bl.newCallID()
+		seq := &callSeq{}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		bl.enqueue(bl.headerEntry(callID, seq.next(), info.FullMethod, md))
+
+		if reqMsg, ok := req.(proto.Message); ok {
+			bl.enqueue(bl.messageEntry(callID, seq.next(), binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE, reqMsg))
+		}
+
+		resp, err := handler(ctx, req)
+
+		if respMsg, ok := resp.(proto.Message); ok {
+			bl.enqueue(bl.messageEntry(callID, seq.next(), binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE, respMsg))
+		}
+
+		bl.enqueue(bl.trailerEntry(callID, seq.next(), err))
+
+		return resp, err
+	}
+}
+
+// BinaryLoggingStreamInterceptor creates a gRPC stream interceptor that asynchronously records
+// each sent/received message to cfg.Sink, filtered by cfg.Rules, with sequence numbers scoped
+// to the call.
+func BinaryLoggingStreamInterceptor(cfg BinaryLogConfig, logger *slog.Logger) grpc.StreamServerInterceptor {
+	return binaryLoggingStreamInterceptor(newBinaryLogger(cfg, logger))
+}
+
+// binaryLoggingStreamInterceptor builds the interceptor against an already-constructed
+// binaryLogger; see binaryLoggingUnaryInterceptor for why this is split out.
+func binaryLoggingStreamInterceptor(bl *binaryLogger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method := splitMethod(info.FullMethod)
+		if !bl.cfg.allowed(service, method) {
+			return handler(srv, ss)
+		}
+
+		callID := bl.newCallID()
+		seq := &callSeq{}
+
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		bl.enqueue(bl.headerEntry(callID, seq.next(), info.FullMethod, md))
+
+		err := handler(srv, &binaryLoggingServerStream{ServerStream: ss, bl: bl, callID: callID, seq: seq})
+
+		bl.enqueue(bl.trailerEntry(callID, seq.next(), err))
+
+		return err
+	}
+}
+
+// binaryLoggingServerStream wraps a grpc.ServerStream to log each message it sends or receives
+type binaryLoggingServerStream struct {
+	grpc.ServerStream
+	bl     *binaryLogger
+	callID uint64
+	seq    *callSeq
+}
+
+func (s *binaryLoggingServerStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		if msg, ok := m.(proto.Message); ok {
+			s.bl.enqueue(s.bl.messageEntry(s.callID, s.seq.next(), binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE, msg))
+		}
+	}
+	return err
+}
+
+func (s *binaryLoggingServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		if msg, ok := m.(proto.Message); ok {
+			s.bl.enqueue(s.bl.messageEntry(s.callID, s.seq.next(), binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE, msg))
+		}
+	}
+	return err
+}
+
+// BinaryLoggingMiddleware creates HTTP middleware that asynchronously records the full
+// request/response body and headers to cfg.Sink, filtered by cfg.Rules (Service is always
+// empty and Method matches r.URL.Path for HTTP traffic).
+func BinaryLoggingMiddleware(cfg BinaryLogConfig, logger *slog.Logger) func(http.Handler) http.Handler {
+	return binaryLoggingMiddleware(newBinaryLogger(cfg, logger))
+}
+
+// binaryLoggingMiddleware builds the middleware against an already-constructed binaryLogger;
+// see binaryLoggingUnaryInterceptor for why this is split out.
+func binaryLoggingMiddleware(bl *binaryLogger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !bl.cfg.allowed("", r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			callID := bl.newCallID()
+			seq := &callSeq{}
+
+			body, _ := io.ReadAll(r.Body)
+			_ = r.Body.Close()
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			bl.enqueue(bl.headerEntry(callID, seq.next(), r.URL.Path, metadata.MD(r.Header)))
+			bl.enqueue(bl.rawMessageEntry(callID, seq.next(), binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE, body))
+
+			rec := &bodyRecorder{ResponseWriter: w, buf: &bytes.Buffer{}}
+			next.ServeHTTP(rec, r)
+
+			bl.enqueue(bl.rawMessageEntry(callID, seq.next(), binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE, rec.buf.Bytes()))
+		})
+	}
+}
+
+// bodyRecorder captures the response body as it is written, while still passing it through
+// to the underlying ResponseWriter
+type bodyRecorder struct {
+	http.ResponseWriter
+	buf *bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.buf.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,62 @@
+package wirego
+
+import "testing"
+
+func TestBinaryLogConfig_Allowed(t *testing.T) {
+	cfg := BinaryLogConfig{
+		Rules: []BinaryLogRule{
+			{Service: "secret.Service", Allow: false},
+			{Method: "Ping", Allow: true},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		service string
+		method  string
+		want    bool
+	}{
+		{"denied by service rule", "secret.Service", "AnyMethod", false},
+		{"allowed by method rule", "other.Service", "Ping", true},
+		{"no matching rule defaults to allowed", "other.Service", "Other", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.allowed(tt.service, tt.method); got != tt.want {
+				t.Errorf("allowed(%q, %q) = %v, want %v", tt.service, tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinaryLogConfig_IsRedacted(t *testing.T) {
+	cfg := BinaryLogConfig{RedactHeaders: []string{"Authorization"}}
+
+	if !cfg.isRedacted("authorization") {
+		t.Error("isRedacted should match case-insensitively")
+	}
+	if cfg.isRedacted("x-request-id") {
+		t.Error("isRedacted should not match unrelated keys")
+	}
+}
+
+func TestBinaryLogConfig_Truncate(t *testing.T) {
+	cfg := BinaryLogConfig{MaxPayloadBytes: 4}
+
+	data, truncated := cfg.truncate([]byte("hello world"))
+	if !truncated || string(data) != "hell" {
+		t.Errorf("truncate() = %q, %v, want %q, true", data, truncated, "hell")
+	}
+
+	data, truncated = cfg.truncate([]byte("hi"))
+	if truncated || string(data) != "hi" {
+		t.Errorf("truncate() = %q, %v, want %q, false", data, truncated, "hi")
+	}
+
+	unbounded := BinaryLogConfig{}
+	data, truncated = unbounded.truncate([]byte("hello world"))
+	if truncated || string(data) != "hello world" {
+		t.Errorf("truncate() with MaxPayloadBytes=0 should pass data through unmodified, got %q, %v", data, truncated)
+	}
+}
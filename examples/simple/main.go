@@ -16,7 +16,7 @@ import (
 type ExampleService struct{}
 
 // RegisterGRPC registers the service with a gRPC server
-func (s *ExampleService) RegisterGRPC(grpcServer *grpc.Server) {
+func (s *ExampleService) RegisterGRPC(grpcServer grpc.ServiceRegistrar) {
 	// In a real application, register your gRPC service here
 	// For example: pb.RegisterYourServiceServer(grpcServer, &yourServiceImpl{})
 	slog.Info("registered gRPC service")
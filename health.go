@@ -3,16 +3,28 @@ package wirego
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
-// HealthHandler creates an HTTP handler for health checks that uses the gRPC health check service
+// HealthChecker is an interface for a named dependency (DB, cache, upstream RPC, ...) whose
+// status is probed in the background and reflected on the gRPC health service under its Name
+type HealthChecker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+// HealthHandler creates an HTTP handler for health checks that uses the gRPC health check
+// service. An optional "service" query parameter selects a specific registered HealthChecker;
+// an empty or missing value reports the overall server status.
 func HealthHandler(healthCheck *health.Server) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Check if the service is serving
-		check, err := healthCheck.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		service := r.URL.Query().Get("service")
+
+		check, err := healthCheck.Check(r.Context(), &healthpb.HealthCheckRequest{Service: service})
 		if err != nil || check.Status != healthpb.HealthCheckResponse_SERVING {
 			w.WriteHeader(http.StatusServiceUnavailable)
 			_, _ = w.Write([]byte("Service Unavailable"))
@@ -53,3 +65,56 @@ func WithHealthEndpoints(mux *http.ServeMux, healthCheck *health.Server, readine
 	// Ready probe - is the service ready to receive traffic?
 	mux.HandleFunc("/readyz", ReadinessHandler(readinessChecks...))
 }
+
+// startHealthCheckers launches a goroutine per registered HealthChecker that probes it on
+// options.healthCheckInterval and reflects its status on the gRPC health service under its
+// Name, so dependents see real dependency state rather than a status fixed at startup
+func (a *App) startHealthCheckers(ctx context.Context, g *errgroup.Group) {
+	for _, checker := range a.options.healthCheckers {
+		checker := checker
+		g.Go(func() error {
+			a.runHealthChecker(ctx, checker)
+			return nil
+		})
+	}
+}
+
+// runHealthChecker probes a single HealthChecker until ctx is done, marking it NOT_SERVING
+// once consecutive failures reach options.healthCheckFailureThreshold
+func (a *App) runHealthChecker(ctx context.Context, checker HealthChecker) {
+	ticker := time.NewTicker(a.options.healthCheckInterval)
+	defer ticker.Stop()
+
+	failures := 0
+	probe := func() {
+		checkCtx, cancel := context.WithTimeout(ctx, a.options.healthCheckTimeout)
+		defer cancel()
+
+		if err := checker.Check(checkCtx); err != nil {
+			failures++
+			a.options.logger.Error("health check failed",
+				"service", checker.Name(),
+				"error", err,
+				"failures", failures,
+			)
+			if failures >= a.options.healthCheckFailureThreshold {
+				a.healthCheck.SetServingStatus(checker.Name(), healthpb.HealthCheckResponse_NOT_SERVING)
+			}
+			return
+		}
+
+		failures = 0
+		a.healthCheck.SetServingStatus(checker.Name(), healthpb.HealthCheckResponse_SERVING)
+	}
+
+	probe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			probe()
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package wirego
+
+import (
+	"context"
+
+	"github.com/fullstorydev/grpchan/inprocgrpc"
+	"google.golang.org/grpc"
+)
+
+// newInprocChannel builds an in-process gRPC channel that dispatches calls made through
+// a grpc.ClientConnInterface directly to services registered on it, without a TCP hop.
+// The channel shares the same interceptor chain as the main gRPC server, so auth, logging,
+// and recovery behave identically on both paths.
+func newInprocChannel(options *Options) *inprocgrpc.Channel {
+	channel := &inprocgrpc.Channel{}
+
+	if unary := chainUnaryInterceptors(options.unaryInterceptors); unary != nil {
+		channel = channel.WithServerUnaryInterceptor(unary)
+	}
+
+	if stream := chainStreamInterceptors(options.streamInterceptors); stream != nil {
+		channel = channel.WithServerStreamInterceptor(stream)
+	}
+
+	return channel
+}
+
+// chainUnaryInterceptors combines multiple unary interceptors into a single one, preserving
+// order: the first interceptor is outermost, mirroring grpc.ChainUnaryInterceptor's semantics.
+// It returns nil if no interceptors are given.
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	if len(interceptors) == 0 {
+		return nil
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, nextHandler := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, nextHandler)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// chainStreamInterceptors combines multiple stream interceptors into a single one, preserving
+// order: the first interceptor is outermost, mirroring grpc.ChainStreamInterceptor's semantics.
+// It returns nil if no interceptors are given.
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	if len(interceptors) == 0 {
+		return nil
+	}
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, nextHandler := interceptors[i], next
+			next = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, nextHandler)
+			}
+		}
+		return next(srv, ss)
+	}
+}
@@ -2,15 +2,30 @@ package wirego
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"path"
 	"time"
 
+	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// remoteAddrFromContext returns the client address associated with ctx, as seen by the
+// transport. When the connection was accepted through a PROXY-protocol-wrapped listener
+// (see WithProxyProtocol), this is the real client address rather than the load balancer's.
+func remoteAddrFromContext(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
 // LoggingUnaryInterceptor creates a gRPC unary interceptor for logging requests
 func LoggingUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
@@ -35,6 +50,7 @@ func LoggingUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 			"method", method,
 			"status", statusCode.String(),
 			"duration", time.Since(start),
+			"remote_addr", remoteAddrFromContext(ctx),
 		)
 
 		return resp, err
@@ -59,19 +75,99 @@ func RecoveryUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
-// ValidationUnaryInterceptor creates a gRPC unary interceptor for validating requests
-func ValidationUnaryInterceptor() grpc.UnaryServerInterceptor {
+// ValidationUnaryInterceptor creates a gRPC unary interceptor for validating requests. It
+// recognizes the legacy protoc-gen-validate Validate() and multi-error ValidateAll() methods
+// and, when validator is non-nil, also validates any proto.Message against buf/validate
+// annotations via protovalidate-go. validator may be nil to support only the legacy methods.
+func ValidationUnaryInterceptor(validator *protovalidate.Validator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if validator, ok := req.(interface{ Validate() error }); ok {
-			if err := validator.Validate(); err != nil {
-				return nil, status.Error(codes.InvalidArgument, err.Error())
-			}
+		if err := validateRequest(req, validator); err != nil {
+			return nil, err
 		}
 
 		return handler(ctx, req)
 	}
 }
 
+// ValidationStreamInterceptor creates a gRPC stream interceptor that validates each message
+// received on the stream using the same rules as ValidationUnaryInterceptor
+func ValidationStreamInterceptor(validator *protovalidate.Validator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss, validator: validator})
+	}
+}
+
+// validatingServerStream wraps a grpc.ServerStream to validate each message as it is received
+type validatingServerStream struct {
+	grpc.ServerStream
+	validator *protovalidate.Validator
+}
+
+// RecvMsg validates m via validateRequest after the embedded ServerStream decodes it
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validateRequest(m, s.validator)
+}
+
+// validateRequest runs the legacy Validate()/ValidateAll() methods on req, if present,
+// followed by protovalidate when validator is non-nil. It returns an InvalidArgument status,
+// with google.rpc.BadRequest field violations attached when protovalidate reports them.
+func validateRequest(req interface{}, validator *protovalidate.Validator) error {
+	switch v := req.(type) {
+	case interface{ ValidateAll() error }:
+		if err := v.ValidateAll(); err != nil {
+			return invalidArgumentError(err, nil)
+		}
+	case interface{ Validate() error }:
+		if err := v.Validate(); err != nil {
+			return invalidArgumentError(err, nil)
+		}
+	}
+
+	if validator == nil {
+		return nil
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	if err := validator.Validate(msg); err != nil {
+		var valErr *protovalidate.ValidationError
+		if errors.As(err, &valErr) {
+			fieldViolations := make([]*errdetails.BadRequest_FieldViolation, len(valErr.Violations))
+			for i, v := range valErr.Violations {
+				fieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+					Field:       v.GetFieldPath(),
+					Description: v.GetMessage(),
+				}
+			}
+			return invalidArgumentError(err, fieldViolations)
+		}
+		return invalidArgumentError(err, nil)
+	}
+
+	return nil
+}
+
+// invalidArgumentError builds an InvalidArgument status from a validation error, attaching a
+// google.rpc.BadRequest detail when field violations are available
+func invalidArgumentError(cause error, fieldViolations []*errdetails.BadRequest_FieldViolation) error {
+	st := status.New(codes.InvalidArgument, cause.Error())
+	if len(fieldViolations) == 0 {
+		return st.Err()
+	}
+
+	withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: fieldViolations})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
 // LoggingStreamInterceptor creates a gRPC stream interceptor for logging requests
 func LoggingStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
@@ -96,6 +192,7 @@ func LoggingStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor
 			"method", method,
 			"status", statusCode.String(),
 			"duration", time.Since(start),
+			"remote_addr", remoteAddrFromContext(ss.Context()),
 		)
 
 		return err
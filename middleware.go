@@ -26,6 +26,7 @@ func LoggingMiddleware(logger *slog.Logger) func(http.Handler) http.Handler {
 				"status", wrapper.status,
 				"duration", duration,
 				"user_agent", r.UserAgent(),
+				"remote_addr", r.RemoteAddr,
 			)
 		})
 	}
@@ -1,11 +1,13 @@
 package wirego
 
 import (
+	"crypto/tls"
 	"log/slog"
 	"net/http"
 	"os"
 	"time"
 
+	"github.com/bufbuild/protovalidate-go"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 )
@@ -15,8 +17,10 @@ type Options struct {
 	// Server configuration
 	grpcPort         int
 	httpPort         int
+	singlePort       int
 	enableReflection bool
 	shutdownTimeout  time.Duration
+	proxyProtocol    bool
 
 	// Middleware and interceptors
 	unaryInterceptors  []grpc.UnaryServerInterceptor
@@ -24,6 +28,31 @@ type Options struct {
 	muxOptions         []runtime.ServeMuxOption
 	httpMiddleware     []func(http.Handler) http.Handler
 
+	// Health checks
+	healthCheckers              []HealthChecker
+	healthCheckInterval         time.Duration
+	healthCheckTimeout          time.Duration
+	healthCheckFailureThreshold int
+
+	// Binary request/response logging
+	binaryLog *BinaryLogConfig
+
+	// Request validation
+	protoValidator *protovalidate.Validator
+
+	// TLS
+	tlsCertFile      string
+	tlsKeyFile       string
+	tlsCAFile        string
+	tlsClientAuth    tls.ClientAuthType
+	tlsConfig        *tls.Config
+	tlsWatchInterval time.Duration
+
+	// Service registry
+	registry         Registry
+	serviceName      string
+	registryMetadata map[string]string
+
 	// Logger
 	logger *slog.Logger
 }
@@ -34,14 +63,20 @@ type Option func(*Options)
 // defaultOptions returns the default configuration
 func defaultOptions() *Options {
 	return &Options{
-		grpcPort:           9000,
-		httpPort:           0,
-		enableReflection:   true,
-		shutdownTimeout:    time.Second * 10,
-		unaryInterceptors:  []grpc.UnaryServerInterceptor{},
-		streamInterceptors: []grpc.StreamServerInterceptor{},
-		muxOptions:         []runtime.ServeMuxOption{},
-		httpMiddleware:     []func(http.Handler) http.Handler{},
+		grpcPort:                    9000,
+		httpPort:                    0,
+		enableReflection:            true,
+		shutdownTimeout:             time.Second * 10,
+		unaryInterceptors:           []grpc.UnaryServerInterceptor{},
+		streamInterceptors:          []grpc.StreamServerInterceptor{},
+		muxOptions:                  []runtime.ServeMuxOption{},
+		httpMiddleware:              []func(http.Handler) http.Handler{},
+		healthCheckers:              []HealthChecker{},
+		healthCheckInterval:         time.Second * 10,
+		healthCheckTimeout:          time.Second * 5,
+		healthCheckFailureThreshold: 1,
+		tlsWatchInterval:            time.Second * 30,
+		registry:                    noopRegistry{},
 		logger: slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 			Level: slog.LevelInfo,
 		})),
@@ -62,6 +97,28 @@ func WithHTTPPort(port int) Option {
 	}
 }
 
+// WithSinglePort enables serving gRPC and the HTTP/JSON gateway on a single TCP port.
+// Connections are multiplexed by content type (HTTP/2 gRPC requests vs. HTTP/1.1),
+// which is useful behind ingress setups that only expose one port (e.g. Kubernetes, Cloud Run).
+// It cannot be combined with WithHTTPPort, nor with WithTLS/WithTLSConfig: the multiplexer
+// matches on the plaintext byte stream, which a TLS handshake never matches.
+func WithSinglePort(port int) Option {
+	return func(o *Options) {
+		o.singlePort = port
+	}
+}
+
+// WithProxyProtocol enables PROXY protocol (v1/v2) support on the gRPC and HTTP listeners, so
+// the real client address is used instead of the upstream load balancer's when wirego sits
+// behind an L4 proxy that speaks the PROXY protocol (e.g. AWS NLB, HAProxy, Envoy). It also
+// wires ForwardedForMiddleware, so HTTP handlers and downstream middleware see the real
+// client address via X-Forwarded-For.
+func WithProxyProtocol(enable bool) Option {
+	return func(o *Options) {
+		o.proxyProtocol = enable
+	}
+}
+
 // WithReflection enables/disables gRPC reflection
 func WithReflection(enable bool) Option {
 	return func(o *Options) {
@@ -104,6 +161,120 @@ func WithHTTPMiddleware(middleware ...func(http.Handler) http.Handler) Option {
 	}
 }
 
+// WithHealthChecks registers named dependency health checks that are probed in the
+// background and reflected on the gRPC health service under their Name, so /healthz and the
+// gRPC Watch stream reflect real dependency state rather than a status fixed at startup
+func WithHealthChecks(checkers ...HealthChecker) Option {
+	return func(o *Options) {
+		o.healthCheckers = append(o.healthCheckers, checkers...)
+	}
+}
+
+// WithHealthCheckInterval sets how often registered HealthCheckers are probed
+func WithHealthCheckInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.healthCheckInterval = interval
+	}
+}
+
+// WithHealthCheckTimeout sets the per-probe timeout for registered HealthCheckers
+func WithHealthCheckTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.healthCheckTimeout = timeout
+	}
+}
+
+// WithHealthCheckFailureThreshold sets how many consecutive probe failures a HealthChecker
+// must accumulate before it is marked NOT_SERVING
+func WithHealthCheckFailureThreshold(threshold int) Option {
+	return func(o *Options) {
+		o.healthCheckFailureThreshold = threshold
+	}
+}
+
+// WithBinaryLog enables binary request/response logging to cfg.Sink for both gRPC and HTTP
+// traffic. See BinaryLogConfig for filtering, redaction, and overflow behavior.
+func WithBinaryLog(cfg BinaryLogConfig) Option {
+	return func(o *Options) {
+		o.binaryLog = &cfg
+	}
+}
+
+// WithProtoValidator wires ValidationUnaryInterceptor and ValidationStreamInterceptor into the
+// gRPC interceptor chains, using validator to additionally check buf/validate annotations
+// (in addition to the legacy Validate()/ValidateAll() methods, which are always checked). Pass
+// a validator pre-compiled once with protovalidate.New so its CEL rules are reused across
+// requests instead of recompiled per call.
+func WithProtoValidator(validator *protovalidate.Validator) Option {
+	return func(o *Options) {
+		o.protoValidator = validator
+	}
+}
+
+// WithTLS enables TLS for the gRPC and HTTP servers using the given certificate/key pair.
+// The pair is reloaded automatically whenever either file changes on disk; the previous
+// certificate is retained until the new one parses cleanly.
+func WithTLS(certFile, keyFile string) Option {
+	return func(o *Options) {
+		o.tlsCertFile = certFile
+		o.tlsKeyFile = keyFile
+	}
+}
+
+// WithMutualTLS enables client certificate verification against the CA bundle in caFile.
+// TLS must also be enabled via WithTLS or WithTLSConfig; NewApp returns an error otherwise.
+func WithMutualTLS(caFile string, clientAuth tls.ClientAuthType) Option {
+	return func(o *Options) {
+		o.tlsCAFile = caFile
+		o.tlsClientAuth = clientAuth
+	}
+}
+
+// WithTLSConfig sets a fully custom tls.Config for the gRPC and HTTP servers, taking
+// precedence over WithTLS. Automatic certificate reloading only applies to certificates
+// loaded via WithTLS.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(o *Options) {
+		o.tlsConfig = cfg
+	}
+}
+
+// WithTLSWatchInterval sets how often the certificate files configured via WithTLS are
+// checked for changes. Defaults to 30 seconds.
+func WithTLSWatchInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.tlsWatchInterval = interval
+	}
+}
+
+// WithRegistry registers the application with an external service registry (e.g. Consul,
+// etcd) for discovery. App.Run registers the instance once listeners are up, advertising
+// Name, a generated instance ID, its gRPC/HTTP endpoints, and any metadata set via
+// WithRegistryMetadata; Shutdown deregisters it before the servers stop. See the
+// wirego/registry/consul and wirego/registry/etcd subpackages for stub implementations
+// with the Config/Registry shape a real client integration will fill in.
+func WithRegistry(registry Registry) Option {
+	return func(o *Options) {
+		o.registry = registry
+	}
+}
+
+// WithServiceName sets the service name advertised to the registry configured via
+// WithRegistry
+func WithServiceName(name string) Option {
+	return func(o *Options) {
+		o.serviceName = name
+	}
+}
+
+// WithRegistryMetadata sets additional metadata (e.g. version, region) advertised to the
+// registry configured via WithRegistry
+func WithRegistryMetadata(metadata map[string]string) Option {
+	return func(o *Options) {
+		o.registryMetadata = metadata
+	}
+}
+
 // WithLogger sets the logger
 func WithLogger(logger *slog.Logger) Option {
 	return func(o *Options) {
@@ -0,0 +1,41 @@
+package wirego
+
+import (
+	"net"
+	"net/http"
+
+	goproxyproto "github.com/pires/go-proxyproto"
+)
+
+// wrapProxyProtocol wraps lis with a PROXY protocol v1/v2 decoder when enabled, so
+// RemoteAddr() on accepted connections reflects the real client address rather than the
+// upstream L4 load balancer's. This, in turn, makes gRPC's peer.Peer and the HTTP server's
+// r.RemoteAddr reflect the true client when wirego runs behind a proxy-protocol-aware LB.
+func wrapProxyProtocol(lis net.Listener, enabled bool) net.Listener {
+	if !enabled {
+		return lis
+	}
+	return &goproxyproto.Listener{Listener: lis}
+}
+
+// ForwardedForMiddleware creates HTTP middleware that overwrites X-Forwarded-For with
+// r.RemoteAddr before calling the next handler. It is meant to run behind WithProxyProtocol,
+// where r.RemoteAddr already reflects the real client address rather than the upstream
+// proxy's, so downstream handlers and logging see the true client through the conventional
+// header instead of having to know about PROXY protocol. The header is overwritten rather
+// than appended to: wirego sits directly behind the proxy that terminates the client
+// connection, so any X-Forwarded-For the client sent itself is untrusted and must not survive.
+func ForwardedForMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			host, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				host = r.RemoteAddr
+			}
+
+			r.Header.Set("X-Forwarded-For", host)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
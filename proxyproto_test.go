@@ -0,0 +1,25 @@
+package wirego
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestForwardedForMiddleware(t *testing.T) {
+	var got string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("X-Forwarded-For")
+	})
+	handler := ForwardedForMiddleware()(next)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "203.0.113.7:54321"
+	req.Header.Set("X-Forwarded-For", "attacker-controlled")
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got != "203.0.113.7" {
+		t.Errorf("X-Forwarded-For = %q, want %q (client-supplied header must not survive)", got, "203.0.113.7")
+	}
+}
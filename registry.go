@@ -0,0 +1,68 @@
+package wirego
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// ServiceInfo describes a running wirego instance for registration with an external service
+// registry.
+type ServiceInfo struct {
+	// Name is the logical service name under which instances are discovered.
+	Name string
+	// ID uniquely identifies this instance among others sharing Name.
+	ID string
+	// GRPCEndpoint is the host:port the gRPC server is reachable on, empty if not serving gRPC.
+	GRPCEndpoint string
+	// HTTPEndpoint is the host:port the HTTP/JSON gateway is reachable on, empty if not serving HTTP.
+	HTTPEndpoint string
+	// Metadata carries arbitrary tags for the registry entry (e.g. version, region).
+	Metadata map[string]string
+}
+
+// Registry registers and deregisters a running instance with an external service discovery
+// system (e.g. Consul, etcd), so other services can find it without hardcoded addresses.
+// wirego calls Register once in Run, after the listeners are up, and Deregister once in
+// Shutdown, before the servers stop.
+type Registry interface {
+	Register(ctx context.Context, info *ServiceInfo) error
+	Deregister(ctx context.Context, info *ServiceInfo) error
+}
+
+// noopRegistry is the default Registry used when WithRegistry is not configured
+type noopRegistry struct{}
+
+func (noopRegistry) Register(context.Context, *ServiceInfo) error   { return nil }
+func (noopRegistry) Deregister(context.Context, *ServiceInfo) error { return nil }
+
+// buildServiceInfo assembles the ServiceInfo advertised to the configured Registry from the
+// application's options and generated instance ID
+func buildServiceInfo(options *Options, instanceID string) *ServiceInfo {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+
+	info := &ServiceInfo{
+		Name:     options.serviceName,
+		ID:       instanceID,
+		Metadata: options.registryMetadata,
+	}
+
+	if options.singlePort > 0 {
+		endpoint := fmt.Sprintf("%s:%d", host, options.singlePort)
+		info.GRPCEndpoint = endpoint
+		info.HTTPEndpoint = endpoint
+		return info
+	}
+
+	if options.grpcPort > 0 {
+		info.GRPCEndpoint = fmt.Sprintf("%s:%d", host, options.grpcPort)
+	}
+	if options.httpPort > 0 {
+		info.HTTPEndpoint = fmt.Sprintf("%s:%d", host, options.httpPort)
+	}
+
+	return info
+}
@@ -0,0 +1,41 @@
+// Package consul provides a wirego.Registry backed by HashiCorp Consul's service catalog.
+//
+// This is currently a stub: Register and Deregister return an error rather than talking to a
+// Consul agent. It exists so applications can depend on a stable import path and wire it up via
+// wirego.WithRegistry without an API change once the Consul client integration lands.
+package consul
+
+import (
+	"context"
+
+	"github.com/rshelekhov/wirego"
+	"github.com/rshelekhov/wirego/registry/internal/stub"
+)
+
+// Config holds the connection settings for the Consul agent used by Registry
+type Config struct {
+	// Address is the address of the Consul HTTP API, e.g. "127.0.0.1:8500"
+	Address string
+}
+
+// Registry is a wirego.Registry backed by Consul
+type Registry struct {
+	cfg Config
+}
+
+// New returns a Consul-backed Registry for the given configuration
+func New(cfg Config) *Registry {
+	return &Registry{cfg: cfg}
+}
+
+// Register implements wirego.Registry
+func (r *Registry) Register(ctx context.Context, info *wirego.ServiceInfo) error {
+	return stub.NotImplementedError("consul")
+}
+
+// Deregister implements wirego.Registry
+func (r *Registry) Deregister(ctx context.Context, info *wirego.ServiceInfo) error {
+	return stub.NotImplementedError("consul")
+}
+
+var _ wirego.Registry = (*Registry)(nil)
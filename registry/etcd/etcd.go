@@ -0,0 +1,42 @@
+// Package etcd provides a wirego.Registry backed by etcd, using a lease-based key per
+// instance the way etcd service discovery is commonly implemented.
+//
+// This is currently a stub: Register and Deregister return an error rather than talking to an
+// etcd cluster. It exists so applications can depend on a stable import path and wire it up via
+// wirego.WithRegistry without an API change once the etcd client integration lands.
+package etcd
+
+import (
+	"context"
+
+	"github.com/rshelekhov/wirego"
+	"github.com/rshelekhov/wirego/registry/internal/stub"
+)
+
+// Config holds the connection settings for the etcd cluster used by Registry
+type Config struct {
+	// Endpoints are the etcd cluster member addresses, e.g. []string{"127.0.0.1:2379"}
+	Endpoints []string
+}
+
+// Registry is a wirego.Registry backed by etcd
+type Registry struct {
+	cfg Config
+}
+
+// New returns an etcd-backed Registry for the given configuration
+func New(cfg Config) *Registry {
+	return &Registry{cfg: cfg}
+}
+
+// Register implements wirego.Registry
+func (r *Registry) Register(ctx context.Context, info *wirego.ServiceInfo) error {
+	return stub.NotImplementedError("etcd")
+}
+
+// Deregister implements wirego.Registry
+func (r *Registry) Deregister(ctx context.Context, info *wirego.ServiceInfo) error {
+	return stub.NotImplementedError("etcd")
+}
+
+var _ wirego.Registry = (*Registry)(nil)
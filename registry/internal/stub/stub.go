@@ -0,0 +1,12 @@
+// Package stub holds the shared error used by the registry subpackages (consul, etcd) until
+// their client integrations land, so the placeholder Register/Deregister bodies don't drift
+// between copies.
+package stub
+
+import "fmt"
+
+// NotImplementedError returns the error a stub Registry's Register/Deregister should return,
+// naming the backend so the message identifies which subpackage produced it.
+func NotImplementedError(backend string) error {
+	return fmt.Errorf("%s: registry not implemented", backend)
+}
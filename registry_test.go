@@ -0,0 +1,30 @@
+package wirego
+
+import "testing"
+
+func TestBuildServiceInfo_SinglePortSharesEndpoint(t *testing.T) {
+	options := defaultOptions()
+	options.singlePort = 9090
+
+	info := buildServiceInfo(options, "instance-1")
+
+	if info.GRPCEndpoint == "" || info.GRPCEndpoint != info.HTTPEndpoint {
+		t.Errorf("single-port mode should advertise the same endpoint for gRPC and HTTP, got %q and %q",
+			info.GRPCEndpoint, info.HTTPEndpoint)
+	}
+}
+
+func TestBuildServiceInfo_SeparatePorts(t *testing.T) {
+	options := defaultOptions()
+	options.grpcPort = 9000
+	options.httpPort = 8080
+
+	info := buildServiceInfo(options, "instance-1")
+
+	if info.GRPCEndpoint == info.HTTPEndpoint {
+		t.Errorf("separate ports should advertise distinct endpoints, both got %q", info.GRPCEndpoint)
+	}
+	if info.ID != "instance-1" {
+		t.Errorf("ID = %q, want %q", info.ID, "instance-1")
+	}
+}
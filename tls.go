@@ -0,0 +1,156 @@
+package wirego
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// tlsManager loads a certificate/key pair and keeps it fresh by polling the files for
+// changes, so certificates can be rotated without restarting the application.
+type tlsManager struct {
+	certFile string
+	keyFile  string
+	logger   *slog.Logger
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// newTLSManager loads the initial certificate/key pair from disk
+func newTLSManager(certFile, keyFile string, logger *slog.Logger) (*tlsManager, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	return &tlsManager{
+		certFile: certFile,
+		keyFile:  keyFile,
+		logger:   logger,
+		cert:     &cert,
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}, nil
+}
+
+// getCertificate is used as tls.Config.GetCertificate, returning the most recently loaded
+// certificate
+func (m *tlsManager) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.cert, nil
+}
+
+// watch polls the certificate/key files at the given interval and reloads them on change.
+// The previous certificate is retained until the new one parses cleanly. It returns when
+// stop is called.
+func (m *tlsManager) watch(interval time.Duration) {
+	defer close(m.doneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastModTime := latestModTime(m.certFile, m.keyFile)
+
+	for {
+		select {
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+			modTime := latestModTime(m.certFile, m.keyFile)
+			if !modTime.After(lastModTime) {
+				continue
+			}
+
+			cert, err := tls.LoadX509KeyPair(m.certFile, m.keyFile)
+			if err != nil {
+				m.logger.Error("failed to reload TLS certificate, keeping previous",
+					"cert_file", m.certFile,
+					"key_file", m.keyFile,
+					"error", err,
+				)
+				continue
+			}
+
+			m.mu.Lock()
+			m.cert = &cert
+			m.mu.Unlock()
+			lastModTime = modTime
+
+			m.logger.Info("reloaded TLS certificate", "cert_file", m.certFile, "key_file", m.keyFile)
+		}
+	}
+}
+
+// stop halts the watch goroutine and waits for it to exit
+func (m *tlsManager) stop() {
+	close(m.stopCh)
+	<-m.doneCh
+}
+
+// latestModTime returns the most recent modification time among files, ignoring ones that
+// cannot be stat'd
+func latestModTime(files ...string) time.Time {
+	var latest time.Time
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			continue
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest
+}
+
+// buildTLSConfig assembles a tls.Config from the TLS-related options, along with the
+// tlsManager watching the certificate files, if any. It returns (nil, nil, nil) when TLS is
+// not configured.
+func buildTLSConfig(options *Options) (*tls.Config, *tlsManager, error) {
+	if options.tlsConfig == nil && options.tlsCertFile == "" {
+		if options.tlsCAFile != "" {
+			return nil, nil, fmt.Errorf("WithMutualTLS requires WithTLS or WithTLSConfig to also be set")
+		}
+		return nil, nil, nil
+	}
+
+	var cfg *tls.Config
+	var manager *tlsManager
+
+	if options.tlsConfig != nil {
+		cfg = options.tlsConfig.Clone()
+	} else {
+		m, err := newTLSManager(options.tlsCertFile, options.tlsKeyFile, options.logger)
+		if err != nil {
+			return nil, nil, err
+		}
+		manager = m
+		cfg = &tls.Config{GetCertificate: manager.getCertificate}
+	}
+
+	if options.tlsCAFile != "" {
+		caCert, err := os.ReadFile(options.tlsCAFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, nil, fmt.Errorf("failed to parse client CA file %q", options.tlsCAFile)
+		}
+
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = options.tlsClientAuth
+	}
+
+	return cfg, manager, nil
+}
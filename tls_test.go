@@ -0,0 +1,22 @@
+package wirego
+
+import "testing"
+
+func TestBuildTLSConfig_NotConfigured(t *testing.T) {
+	options := defaultOptions()
+
+	cfg, mgr, err := buildTLSConfig(options)
+	if err != nil || cfg != nil || mgr != nil {
+		t.Errorf("buildTLSConfig() = %v, %v, %v, want nil, nil, nil", cfg, mgr, err)
+	}
+}
+
+func TestBuildTLSConfig_RejectsCAOnlyMutualTLS(t *testing.T) {
+	options := defaultOptions()
+	options.tlsCAFile = "ca.pem"
+
+	_, _, err := buildTLSConfig(options)
+	if err == nil {
+		t.Fatal("buildTLSConfig() should reject WithMutualTLS without WithTLS/WithTLSConfig")
+	}
+}